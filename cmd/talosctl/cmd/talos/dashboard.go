@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	dashboardapp "github.com/siderolabs/talos/internal/pkg/dashboard/app"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// NOTE: registering this command with the root `talosctl` command tree
+// (cmd/talosctl/cmd/talos/root.go, not present in this series) is a one-line
+// `rootCmd.AddCommand(dashboardCmd)` follow-up, same as every other command in this package.
+
+var dashboardCmdFlags struct {
+	updateInterval time.Duration
+	pluginDir      string
+	metricsAddr    string
+}
+
+// dashboardCmd represents the `talosctl dashboard` command.
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Cluster dashboard with real-time metrics",
+	Long: `Dashboard provides an interactive, real-time view of CPU, memory, disk and network
+utilization, and any temperature/fan sensors, for one or more nodes. Pass --metrics-addr to
+additionally expose everything it collects as Prometheus metrics, and --plugin-dir to load
+extra panels (see internal/pkg/dashboard/plugin).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return helpers.WithClient(runDashboard)
+	},
+}
+
+func init() {
+	dashboardCmd.Flags().DurationVar(&dashboardCmdFlags.updateInterval, "update-interval", 2*time.Second, "interval between Talos API polls")
+	dashboardCmd.Flags().StringVar(&dashboardCmdFlags.pluginDir, "plugin-dir", "", "load extra dashboard widgets (*.so) from this directory")
+	dashboardCmd.Flags().StringVar(&dashboardCmdFlags.metricsAddr, "metrics-addr", "", "additionally expose dashboard metrics for Prometheus to scrape on this address, e.g. :9101")
+}
+
+func runDashboard(ctx context.Context, c *client.Client) error {
+	dashboard, err := dashboardapp.New(dashboardapp.Options{
+		Nodes:       GlobalArgs.Nodes,
+		PluginDir:   dashboardCmdFlags.pluginDir,
+		MetricsAddr: dashboardCmdFlags.metricsAddr,
+	})
+	if err != nil {
+		return fmt.Errorf("initializing dashboard: %w", err)
+	}
+
+	poller := newAPIPoller(c, dashboard, dashboardCmdFlags.updateInterval)
+
+	go poller.Run(ctx)
+
+	return dashboard.Run(ctx)
+}
+
+// apiPoller periodically polls every node's MachineService and feeds the results into the
+// dashboard. It keeps one apidata.Node per node across ticks, since apidata's rate-based
+// metrics (CPU %, disk/net throughput) are derived from the delta between consecutive polls.
+//
+// NOTE: this only drives the apidata.APIDataListener half of the dashboard (Header's CPU/mem/
+// uptime/net/disk fields, CPUDetail, DiskIO, NetIO, Graphs, the exporter). The
+// resourcedata.ResourceDataListener half (hostname and hardware.SensorStatus, which feed
+// Sensors and part of Header) needs a COSI state watch against the controller runtime rather
+// than a plain MachineServiceClient call, and is not wired up here - that's the one piece of
+// this series' dead-code gap this command doesn't close yet.
+type apiPoller struct {
+	client    *client.Client
+	dashboard *dashboardapp.App
+	interval  time.Duration
+	data      *apidata.Data
+}
+
+func newAPIPoller(c *client.Client, dashboard *dashboardapp.App, interval time.Duration) *apiPoller {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+
+	return &apiPoller{
+		client:    c,
+		dashboard: dashboard,
+		interval:  interval,
+		data:      apidata.NewData(),
+	}
+}
+
+// Run polls every node once per tick until ctx is canceled. It has nowhere to report per-node
+// errors to other than swallowing them - a node that's briefly unreachable should leave its
+// panels showing stale data, not crash the dashboard.
+func (p *apiPoller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, node := range p.dashboard.Nodes() {
+			p.pollNode(ctx, node)
+			p.dashboard.UpdateAPIData(node, p.data)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *apiPoller) pollNode(ctx context.Context, node string) {
+	nodeCtx := client.WithNode(ctx, node)
+
+	nodeData, ok := p.data.Nodes[node]
+	if !ok {
+		nodeData = &apidata.Node{}
+		p.data.Nodes[node] = nodeData
+	}
+
+	if resp, err := p.client.MachineClient.Hostname(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.Hostname = resp
+	}
+
+	if resp, err := p.client.MachineClient.Version(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.Version = resp
+	}
+
+	if resp, err := p.client.MachineClient.SystemStat(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.SetSystemStat(resp)
+	}
+
+	if resp, err := p.client.MachineClient.CPUsInfo(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.CPUsInfo = resp
+	}
+
+	if resp, err := p.client.MachineClient.Processes(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.Processes = resp
+	}
+
+	if resp, err := p.client.MachineClient.Memory(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.Memory = resp
+	}
+
+	if resp, err := p.client.MachineClient.DiskStats(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.SetDiskStats(resp, time.Now())
+	}
+
+	if resp, err := p.client.MachineClient.NetworkDeviceStats(nodeCtx, &emptypb.Empty{}); err == nil {
+		nodeData.SetNetworkDeviceStats(resp, time.Now())
+	}
+}
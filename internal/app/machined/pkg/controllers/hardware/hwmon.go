@@ -0,0 +1,176 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultHwmonRoot = "/sys/class/hwmon"
+
+var tempInputRe = regexp.MustCompile(`^temp(\d+)_input$`)
+
+var fanInputRe = regexp.MustCompile(`^fan(\d+)_input$`)
+
+// sensorReading is a single hwmon sensor reading, either a temperature or a fan speed.
+type sensorReading struct {
+	Chip                    string
+	Label                   string
+	TemperatureMilliCelsius *int64
+	FanRPM                  *uint32
+	MaxMilliCelsius         *int64
+	CritMilliCelsius        *int64
+}
+
+// readHwmonSensors walks a `/sys/class/hwmon`-shaped directory tree and returns every
+// temperature and fan sensor it can find, sorted by chip then label.
+//
+// A missing root is not an error: not every machine has hwmon drivers loaded.
+func readHwmonSensors(root string) ([]sensorReading, error) {
+	chips, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var readings []sensorReading
+
+	for _, chip := range chips {
+		chipDir := filepath.Join(root, chip.Name())
+
+		name, ok := readHwmonAttr(filepath.Join(chipDir, "name"))
+		if !ok {
+			continue
+		}
+
+		entries, err := os.ReadDir(chipDir)
+		if err != nil {
+			continue
+		}
+
+		readings = append(readings, readTemps(chipDir, name, entries)...)
+		readings = append(readings, readFans(chipDir, name, entries)...)
+	}
+
+	sort.Slice(readings, func(i, j int) bool {
+		if readings[i].Chip != readings[j].Chip {
+			return readings[i].Chip < readings[j].Chip
+		}
+
+		return readings[i].Label < readings[j].Label
+	})
+
+	return readings, nil
+}
+
+func readTemps(chipDir, chip string, entries []os.DirEntry) []sensorReading {
+	var readings []sensorReading
+
+	for _, entry := range entries {
+		m := tempInputRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+
+		idx := m[1]
+
+		value, ok := readHwmonInt(filepath.Join(chipDir, "temp"+idx+"_input"))
+		if !ok {
+			continue
+		}
+
+		label, ok := readHwmonAttr(filepath.Join(chipDir, "temp"+idx+"_label"))
+		if !ok {
+			label = "temp" + idx
+		}
+
+		reading := sensorReading{
+			Chip:                    chip,
+			Label:                   label,
+			TemperatureMilliCelsius: &value,
+		}
+
+		if max, ok := readHwmonInt(filepath.Join(chipDir, "temp"+idx+"_max")); ok {
+			reading.MaxMilliCelsius = &max
+		}
+
+		if crit, ok := readHwmonInt(filepath.Join(chipDir, "temp"+idx+"_crit")); ok {
+			reading.CritMilliCelsius = &crit
+		}
+
+		readings = append(readings, reading)
+	}
+
+	return readings
+}
+
+func readFans(chipDir, chip string, entries []os.DirEntry) []sensorReading {
+	var readings []sensorReading
+
+	for _, entry := range entries {
+		m := fanInputRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+
+		idx := m[1]
+
+		value, ok := readHwmonInt(filepath.Join(chipDir, "fan"+idx+"_input"))
+		if !ok {
+			continue
+		}
+
+		label, ok := readHwmonAttr(filepath.Join(chipDir, "fan"+idx+"_label"))
+		if !ok {
+			label = "fan" + idx
+		}
+
+		if value < 0 || value > math.MaxUint32 {
+			continue
+		}
+
+		rpm := uint32(value)
+
+		readings = append(readings, sensorReading{
+			Chip:   chip,
+			Label:  label,
+			FanRPM: &rpm,
+		})
+	}
+
+	return readings
+}
+
+func readHwmonAttr(path string) (string, bool) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+
+	return strings.TrimSpace(string(contents)), true
+}
+
+func readHwmonInt(path string) (int64, bool) {
+	raw, ok := readHwmonAttr(path)
+	if !ok {
+		return 0, false
+	}
+
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeHwmonFile(t *testing.T, path, contents string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+}
+
+func TestReadHwmonSensorsMissingRoot(t *testing.T) {
+	readings, err := readHwmonSensors(filepath.Join(t.TempDir(), "does-not-exist"))
+	require.NoError(t, err)
+	assert.Empty(t, readings)
+}
+
+func TestReadHwmonSensorsCoretemp(t *testing.T) {
+	root := t.TempDir()
+	chip := filepath.Join(root, "hwmon0")
+
+	writeHwmonFile(t, filepath.Join(chip, "name"), "coretemp\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_label"), "Package id 0\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_input"), "45000\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_max"), "100000\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_crit"), "100000\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp2_label"), "Core 0\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp2_input"), "42000\n")
+
+	readings, err := readHwmonSensors(root)
+	require.NoError(t, err)
+	require.Len(t, readings, 2)
+
+	assert.Equal(t, "coretemp", readings[0].Chip)
+	assert.Equal(t, "Core 0", readings[0].Label)
+	require.NotNil(t, readings[0].TemperatureMilliCelsius)
+	assert.EqualValues(t, 42000, *readings[0].TemperatureMilliCelsius)
+
+	assert.Equal(t, "Package id 0", readings[1].Label)
+	require.NotNil(t, readings[1].MaxMilliCelsius)
+	assert.EqualValues(t, 100000, *readings[1].MaxMilliCelsius)
+}
+
+func TestReadHwmonSensorsK10temp(t *testing.T) {
+	root := t.TempDir()
+	chip := filepath.Join(root, "hwmon1")
+
+	writeHwmonFile(t, filepath.Join(chip, "name"), "k10temp\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_label"), "Tctl\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_input"), "55125\n")
+
+	readings, err := readHwmonSensors(root)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+
+	assert.Equal(t, "k10temp", readings[0].Chip)
+	assert.Equal(t, "Tctl", readings[0].Label)
+	assert.Nil(t, readings[0].MaxMilliCelsius)
+}
+
+func TestReadHwmonSensorsNVMe(t *testing.T) {
+	root := t.TempDir()
+	chip := filepath.Join(root, "hwmon2")
+
+	writeHwmonFile(t, filepath.Join(chip, "name"), "nvme\n")
+	writeHwmonFile(t, filepath.Join(chip, "temp1_input"), "36850\n")
+
+	readings, err := readHwmonSensors(root)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+
+	assert.Equal(t, "nvme", readings[0].Chip)
+	// No temp1_label was provided, so the reading falls back to a synthetic label.
+	assert.Equal(t, "temp1", readings[0].Label)
+}
+
+func TestReadHwmonSensorsFan(t *testing.T) {
+	root := t.TempDir()
+	chip := filepath.Join(root, "hwmon3")
+
+	writeHwmonFile(t, filepath.Join(chip, "name"), "nct6775\n")
+	writeHwmonFile(t, filepath.Join(chip, "fan1_input"), "1200\n")
+
+	readings, err := readHwmonSensors(root)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+
+	require.NotNil(t, readings[0].FanRPM)
+	assert.EqualValues(t, 1200, *readings[0].FanRPM)
+}
+
+func TestReadHwmonSensorsFanNegative(t *testing.T) {
+	root := t.TempDir()
+	chip := filepath.Join(root, "hwmon3")
+
+	writeHwmonFile(t, filepath.Join(chip, "name"), "nct6775\n")
+	writeHwmonFile(t, filepath.Join(chip, "fan1_input"), "-1\n")
+
+	readings, err := readHwmonSensors(root)
+	require.NoError(t, err)
+	assert.Empty(t, readings)
+}
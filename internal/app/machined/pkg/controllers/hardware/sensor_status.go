@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+const sensorPollInterval = 5 * time.Second
+
+// SensorStatusController polls `/sys/class/hwmon` and publishes a hardware.SensorStatus
+// resource for every temperature and fan sensor found.
+type SensorStatusController struct {
+	// HwmonRoot overrides the hwmon sysfs root, for testing. Defaults to /sys/class/hwmon.
+	HwmonRoot string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SensorStatusController) Name() string {
+	return "hardware.SensorStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SensorStatusController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SensorStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.SensorStatusType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SensorStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	root := ctrl.HwmonRoot
+	if root == "" {
+		root = defaultHwmonRoot
+	}
+
+	ticker := time.NewTicker(sensorPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		readings, err := readHwmonSensors(root)
+		if err != nil {
+			logger.Warn("failed reading hwmon sensors", zap.Error(err))
+
+			continue
+		}
+
+		touched := make(map[resource.ID]struct{}, len(readings))
+
+		for _, reading := range readings {
+			id := fmt.Sprintf("%s/%s", reading.Chip, reading.Label)
+			touched[id] = struct{}{}
+
+			if err = safe.WriterModify(ctx, r, hardware.NewSensorStatus(id), func(status *hardware.SensorStatus) error {
+				spec := status.TypedSpec()
+
+				spec.Chip = reading.Chip
+				spec.Label = reading.Label
+				spec.TemperatureCelsius = milliCelsiusToCelsius(reading.TemperatureMilliCelsius)
+				spec.FanRPM = reading.FanRPM
+				spec.MaxCelsius = milliCelsiusToCelsius(reading.MaxMilliCelsius)
+				spec.CriticalCelsius = milliCelsiusToCelsius(reading.CritMilliCelsius)
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error modifying sensor status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*hardware.SensorStatus](ctx, r, func(status *hardware.SensorStatus) bool {
+			_, ok := touched[status.Metadata().ID()]
+
+			return !ok
+		}); err != nil {
+			return fmt.Errorf("error cleaning up sensor statuses: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func milliCelsiusToCelsius(milli *int64) *float64 {
+	if milli == nil {
+		return nil
+	}
+
+	celsius := float64(*milli) / 1000
+
+	return &celsius
+}
@@ -0,0 +1,124 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package apidata provides a data structure and methods to update it for the dashboard.
+package apidata
+
+import (
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// Data is the data structure for the dashboard, polled from the Talos API of every node.
+type Data struct {
+	Nodes map[string]*Node
+}
+
+// NewData creates a new Data.
+func NewData() *Data {
+	return &Data{
+		Nodes: map[string]*Node{},
+	}
+}
+
+// Node is the data for a single node.
+//
+// NOTE: there is deliberately no load-average field here. Rendering it would need a machined
+// RPC reading /proc/loadavg that doesn't exist anywhere in this series (no .proto change, no
+// machined controller) - a field that can only ever read zero would mislead operators into
+// thinking the node is idle. Add it back together with the RPC and the dashboard's polling
+// wire-up, not before.
+type Node struct {
+	Hostname   *machine.HostnameResponse
+	Version    *machine.VersionResponse
+	SystemStat *machine.SystemStatResponse
+	CPUsInfo   *machine.CPUsInfoResponse
+	Processes  *machine.ProcessesResponse
+	Memory     *machine.MemoryResponse
+
+	DiskStats          *machine.DiskStatsResponse
+	NetworkDeviceStats *machine.NetworkDeviceStatsResponse
+
+	prevSystemStat  *machine.SystemStatResponse
+	prevDiskStats   *machine.DiskStatsResponse
+	diskStatsAt     time.Time
+	prevDiskStatsAt time.Time
+
+	prevNetworkDeviceStats   *machine.NetworkDeviceStatsResponse
+	networkDeviceStatsAt     time.Time
+	prevNetworkDeviceStatsAt time.Time
+}
+
+// SetSystemStat updates the node's system stat, keeping the previous sample around so that
+// rate-based metrics (CPU usage, etc.) can be derived from the delta between the two.
+func (node *Node) SetSystemStat(stat *machine.SystemStatResponse) {
+	node.prevSystemStat = node.SystemStat
+	node.SystemStat = stat
+}
+
+// CPUUsageByName returns the CPU usage ratio (0.0 - 1.0) for the CPU line with the given name,
+// e.g., "usage" for the aggregate across all cores, or "cpu0", "cpu1", ... for individual cores.
+//
+// It is computed as the delta of busy/total jiffies between the two most recent samples, so it
+// returns zero until a second sample has been observed.
+func (node *Node) CPUUsageByName(name string) float64 {
+	if name == "usage" {
+		name = "cpu"
+	}
+
+	cur := findCPUStat(node.SystemStat, name)
+	prev := findCPUStat(node.prevSystemStat, name)
+
+	if cur == nil || prev == nil {
+		return 0
+	}
+
+	return cpuUsageRatio(prev, cur)
+}
+
+// MemUsage returns the memory usage ratio (0.0 - 1.0).
+func (node *Node) MemUsage() float64 {
+	if node.Memory == nil {
+		return 0
+	}
+
+	meminfo := node.Memory.GetMeminfo()
+
+	total := meminfo.GetMemtotal()
+	if total == 0 {
+		return 0
+	}
+
+	used := total - meminfo.GetMemfree() - meminfo.GetCached() - meminfo.GetBuffers()
+
+	return float64(used) / float64(total)
+}
+
+func findCPUStat(stat *machine.SystemStatResponse, id string) *machine.CPUStat {
+	for _, cpu := range stat.GetCpu() {
+		if cpu.GetId() == id {
+			return cpu
+		}
+	}
+
+	return nil
+}
+
+func cpuUsageRatio(prev, cur *machine.CPUStat) float64 {
+	prevIdle := prev.GetIdle() + prev.GetIowait()
+	curIdle := cur.GetIdle() + cur.GetIowait()
+
+	prevTotal := prevIdle + prev.GetUser() + prev.GetNice() + prev.GetSystem() + prev.GetIrq() + prev.GetSoftIrq() + prev.GetSteal()
+	curTotal := curIdle + cur.GetUser() + cur.GetNice() + cur.GetSystem() + cur.GetIrq() + cur.GetSoftIrq() + cur.GetSteal()
+
+	totalDelta := curTotal - prevTotal
+	if totalDelta == 0 {
+		return 0
+	}
+
+	idleDelta := curIdle - prevIdle
+
+	return 1 - float64(idleDelta)/float64(totalDelta)
+}
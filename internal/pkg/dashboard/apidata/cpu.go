@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CPUCoreUsage is the per-category CPU time breakdown for a single core, as a ratio (0.0 - 1.0)
+// of the total time elapsed between the two most recent samples.
+type CPUCoreUsage struct {
+	ID      string
+	User    float64
+	Nice    float64
+	System  float64
+	Idle    float64 // idle and iowait time together, so IOWait is also included here
+	IOWait  float64
+	IRQ     float64
+	SoftIRQ float64
+	Steal   float64
+}
+
+type cpuTimes interface {
+	GetUser() uint64
+	GetNice() uint64
+	GetSystem() uint64
+	GetIdle() uint64
+	GetIowait() uint64
+	GetIrq() uint64
+	GetSoftIrq() uint64
+	GetSteal() uint64
+}
+
+// CPUUsagePerCore returns the aggregate busy ratio (0.0 - 1.0) of every core, ordered by core
+// index. It is empty until a second sample has been observed.
+func (node *Node) CPUUsagePerCore() []float64 {
+	breakdown := node.CPUBreakdown()
+
+	usage := make([]float64, 0, len(breakdown))
+	for _, core := range breakdown {
+		usage = append(usage, 1-core.Idle)
+	}
+
+	return usage
+}
+
+// CPUBreakdown returns the per-category CPU time breakdown of every core (excluding the
+// aggregate "cpu" line), ordered by core index.
+func (node *Node) CPUBreakdown() []CPUCoreUsage {
+	if node.SystemStat == nil || node.prevSystemStat == nil {
+		return nil
+	}
+
+	breakdown := make([]CPUCoreUsage, 0, len(node.SystemStat.GetCpu()))
+
+	for _, cur := range node.SystemStat.GetCpu() {
+		if cur.GetId() == "cpu" {
+			continue
+		}
+
+		prev := findCPUStat(node.prevSystemStat, cur.GetId())
+		if prev == nil {
+			continue
+		}
+
+		total := cpuTotalDelta(prev, cur)
+		if total == 0 {
+			continue
+		}
+
+		breakdown = append(breakdown, CPUCoreUsage{
+			ID:      cur.GetId(),
+			User:    float64(cur.GetUser()-prev.GetUser()) / total,
+			Nice:    float64(cur.GetNice()-prev.GetNice()) / total,
+			System:  float64(cur.GetSystem()-prev.GetSystem()) / total,
+			Idle:    float64((cur.GetIdle()+cur.GetIowait())-(prev.GetIdle()+prev.GetIowait())) / total,
+			IOWait:  float64(cur.GetIowait()-prev.GetIowait()) / total,
+			IRQ:     float64(cur.GetIrq()-prev.GetIrq()) / total,
+			SoftIRQ: float64(cur.GetSoftIrq()-prev.GetSoftIrq()) / total,
+			Steal:   float64(cur.GetSteal()-prev.GetSteal()) / total,
+		})
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool { return coreIndex(breakdown[i].ID) < coreIndex(breakdown[j].ID) })
+
+	return breakdown
+}
+
+// coreIndex parses the numeric suffix of a core ID like "cpu0", "cpu12", so that cores sort
+// numerically (cpu0, cpu1, ..., cpu9, cpu10, ...) rather than lexicographically.
+func coreIndex(id string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(id, "cpu"))
+	if err != nil {
+		return -1
+	}
+
+	return index
+}
+
+func cpuTotalDelta(prev, cur cpuTimes) float64 {
+	prevTotal := prev.GetUser() + prev.GetNice() + prev.GetSystem() + prev.GetIdle() + prev.GetIowait() + prev.GetIrq() + prev.GetSoftIrq() + prev.GetSteal()
+	curTotal := cur.GetUser() + cur.GetNice() + cur.GetSystem() + cur.GetIdle() + cur.GetIowait() + cur.GetIrq() + cur.GetSoftIrq() + cur.GetSteal()
+
+	return float64(curTotal - prevTotal)
+}
@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func cpuStat(id string, user, idle uint64) *machine.CPUStat {
+	return &machine.CPUStat{
+		Id:   id,
+		User: user,
+		Idle: idle,
+	}
+}
+
+func TestCPUBreakdownOrdersCoresNumerically(t *testing.T) {
+	node := &Node{
+		prevSystemStat: &machine.SystemStatResponse{
+			Cpu: []*machine.CPUStat{
+				cpuStat("cpu", 0, 0),
+				cpuStat("cpu1", 0, 100),
+				cpuStat("cpu10", 0, 100),
+				cpuStat("cpu2", 0, 100),
+			},
+		},
+		SystemStat: &machine.SystemStatResponse{
+			Cpu: []*machine.CPUStat{
+				cpuStat("cpu", 50, 50),
+				cpuStat("cpu1", 10, 110),
+				cpuStat("cpu10", 20, 120),
+				cpuStat("cpu2", 30, 130),
+			},
+		},
+	}
+
+	breakdown := node.CPUBreakdown()
+	require.Len(t, breakdown, 3)
+
+	ids := make([]string, len(breakdown))
+	for i, core := range breakdown {
+		ids[i] = core.ID
+	}
+
+	assert.Equal(t, []string{"cpu1", "cpu2", "cpu10"}, ids)
+}
+
+func TestCPUBreakdownEmptyWithoutPreviousSample(t *testing.T) {
+	node := &Node{
+		SystemStat: &machine.SystemStatResponse{
+			Cpu: []*machine.CPUStat{cpuStat("cpu1", 10, 90)},
+		},
+	}
+
+	assert.Empty(t, node.CPUBreakdown())
+}
+
+func TestCoreIndex(t *testing.T) {
+	assert.Equal(t, 0, coreIndex("cpu0"))
+	assert.Equal(t, 12, coreIndex("cpu12"))
+	assert.Equal(t, -1, coreIndex("cpu"))
+	assert.Equal(t, -1, coreIndex("not-a-core"))
+}
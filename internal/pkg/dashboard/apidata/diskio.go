@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"sort"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// DiskIORate is the read/write throughput of a single block device, in bytes per second.
+type DiskIORate struct {
+	Device     string
+	ReadBytes  float64
+	WriteBytes float64
+}
+
+// SetDiskStats updates the node's disk stats, keeping the previous sample (and its sample time)
+// around so that DiskIORates can compute a bytes-per-second rate from the delta between the two.
+func (node *Node) SetDiskStats(stats *machine.DiskStatsResponse, now time.Time) {
+	node.prevDiskStats = node.DiskStats
+	node.prevDiskStatsAt = node.diskStatsAt
+
+	node.DiskStats = stats
+	node.diskStatsAt = now
+}
+
+// DiskIORates returns the read/write throughput of every block device known in the most recent
+// sample, ordered by device name. Devices that were not present in the previous sample (e.g. a
+// disk that was just attached) are skipped, since no rate can be derived for them yet.
+func (node *Node) DiskIORates() []DiskIORate {
+	if node.DiskStats == nil || node.prevDiskStats == nil {
+		return nil
+	}
+
+	elapsed := node.diskStatsAt.Sub(node.prevDiskStatsAt).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+
+	prevByDevice := make(map[string]*machine.DiskStat, len(node.prevDiskStats.GetDevices()))
+	for _, disk := range node.prevDiskStats.GetDevices() {
+		prevByDevice[disk.GetDevice()] = disk
+	}
+
+	rates := make([]DiskIORate, 0, len(node.DiskStats.GetDevices()))
+
+	for _, disk := range node.DiskStats.GetDevices() {
+		prev, ok := prevByDevice[disk.GetDevice()]
+		if !ok {
+			continue
+		}
+
+		readDelta := deltaUint64(prev.GetReadSectors(), disk.GetReadSectors())
+		writeDelta := deltaUint64(prev.GetWriteSectors(), disk.GetWriteSectors())
+
+		const sectorSize = 512
+
+		rates = append(rates, DiskIORate{
+			Device:     disk.GetDevice(),
+			ReadBytes:  float64(readDelta) * sectorSize / elapsed,
+			WriteBytes: float64(writeDelta) * sectorSize / elapsed,
+		})
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Device < rates[j].Device })
+
+	return rates
+}
+
+// TotalDiskBytes returns the cumulative read/write byte counters summed across every block
+// device in the most recent sample. Unlike DiskIORates, this is a monotonic counter suitable
+// for exporting as a Prometheus counter metric.
+func (node *Node) TotalDiskBytes() (read, write uint64) {
+	if node.DiskStats == nil {
+		return 0, 0
+	}
+
+	const sectorSize = 512
+
+	for _, disk := range node.DiskStats.GetDevices() {
+		read += disk.GetReadSectors() * sectorSize
+		write += disk.GetWriteSectors() * sectorSize
+	}
+
+	return read, write
+}
+
+// deltaUint64 returns the number of times a monotonically-increasing counter ticked between
+// two samples. Unsigned subtraction wraps modulo 2^64, so this is correct even if the counter
+// itself wrapped around between samples - cur-prev still yields the right delta as long as it
+// didn't wrap more than once in between.
+func deltaUint64(prev, cur uint64) uint64 {
+	return cur - prev
+}
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestDeltaUint64(t *testing.T) {
+	assert.EqualValues(t, 5, deltaUint64(10, 15))
+	assert.Equal(t, uint64(0), deltaUint64(10, 10))
+
+	// A counter that wrapped around 2^64 between samples still yields the correct, small delta
+	// via unsigned modular subtraction - it must not be clamped to zero.
+	assert.EqualValues(t, 5, deltaUint64(math.MaxUint64-4, 0))
+}
+
+func TestDiskIORatesSkipsDeviceNotInPreviousSample(t *testing.T) {
+	now := time.Now()
+
+	node := &Node{}
+	node.SetDiskStats(&machine.DiskStatsResponse{
+		Devices: []*machine.DiskStat{
+			{Device: "sda", ReadSectors: 1000, WriteSectors: 500},
+		},
+	}, now)
+	node.SetDiskStats(&machine.DiskStatsResponse{
+		Devices: []*machine.DiskStat{
+			{Device: "sda", ReadSectors: 1200, WriteSectors: 600},
+			{Device: "sdb", ReadSectors: 100, WriteSectors: 50},
+		},
+	}, now.Add(time.Second))
+
+	rates := node.DiskIORates()
+	require.Len(t, rates, 1)
+	assert.Equal(t, "sda", rates[0].Device)
+	assert.InDelta(t, 200*512, rates[0].ReadBytes, 0.001)
+	assert.InDelta(t, 100*512, rates[0].WriteBytes, 0.001)
+}
+
+func TestTotalDiskBytesSumsAcrossDevices(t *testing.T) {
+	node := &Node{}
+	node.SetDiskStats(&machine.DiskStatsResponse{
+		Devices: []*machine.DiskStat{
+			{Device: "sda", ReadSectors: 10, WriteSectors: 20},
+			{Device: "sdb", ReadSectors: 30, WriteSectors: 40},
+		},
+	}, time.Now())
+
+	read, write := node.TotalDiskBytes()
+	assert.EqualValues(t, 40*512, read)
+	assert.EqualValues(t, 60*512, write)
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultHistoryWindow is the default length of time a History keeps samples for. It must cover
+// the largest zoom level offered by components.Graphs, or zooming out becomes a silent no-op.
+const DefaultHistoryWindow = time.Hour
+
+// Sample is a single point-in-time snapshot of the metrics worth graphing over time.
+//
+// There's no Load1 field: that would need a machined RPC reading /proc/loadavg, which isn't
+// part of this series (see apidata.Node's doc comment) - plotting a load average that can only
+// ever be zero would be worse than not having the series at all.
+type Sample struct {
+	Time           time.Time
+	CPUUsageRatio  float64
+	MemUsageRatio  float64
+	NetRxBytes     float64
+	NetTxBytes     float64
+	DiskReadBytes  float64
+	DiskWriteBytes float64
+}
+
+// History is a ring buffer of Samples covering the most recent `window` of time for one node.
+type History struct {
+	mu      sync.Mutex
+	window  time.Duration
+	samples []Sample
+}
+
+// NewHistory creates a History that keeps samples within the given window.
+func NewHistory(window time.Duration) *History {
+	return &History{window: window}
+}
+
+// Add appends a sample and evicts anything older than the window.
+func (h *History) Add(sample Sample) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples = append(h.samples, sample)
+
+	cutoff := sample.Time.Add(-h.window)
+
+	evict := 0
+	for evict < len(h.samples) && h.samples[evict].Time.Before(cutoff) {
+		evict++
+	}
+
+	h.samples = h.samples[evict:]
+}
+
+// Samples returns a copy of the samples currently in the window, oldest first.
+func (h *History) Samples() []Sample {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := make([]Sample, len(h.samples))
+	copy(samples, h.samples)
+
+	return samples
+}
+
+// HistoryProvider looks up the History for a node, so that widgets can share one set of ring
+// buffers instead of each recording their own.
+type HistoryProvider interface {
+	History(node string) *History
+}
+
+// Histories is an APIDataListener that records a Sample for every node on every API poll, and
+// the default HistoryProvider implementation.
+type Histories struct {
+	mu     sync.Mutex
+	window time.Duration
+	byNode map[string]*History
+}
+
+// NewHistories creates a Histories recording into per-node History buffers of the given window.
+func NewHistories(window time.Duration) *Histories {
+	return &Histories{
+		window: window,
+		byNode: make(map[string]*History),
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (h *Histories) OnAPIDataChange(node string, data *Data) {
+	nodeData := data.Nodes[node]
+	if nodeData == nil {
+		return
+	}
+
+	netRate := nodeData.TotalNetIORate()
+	diskRate := nodeData.TotalDiskIORate()
+
+	h.History(node).Add(Sample{
+		Time:           time.Now(),
+		CPUUsageRatio:  nodeData.CPUUsageByName("usage"),
+		MemUsageRatio:  nodeData.MemUsage(),
+		NetRxBytes:     netRate.RxBytes,
+		NetTxBytes:     netRate.TxBytes,
+		DiskReadBytes:  diskRate.ReadBytes,
+		DiskWriteBytes: diskRate.WriteBytes,
+	})
+}
+
+// History implements the HistoryProvider interface.
+func (h *Histories) History(node string) *History {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	history, ok := h.byNode[node]
+	if !ok {
+		history = NewHistory(h.window)
+		h.byNode[node] = history
+	}
+
+	return history
+}
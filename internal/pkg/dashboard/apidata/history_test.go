@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryEvictsSamplesOutsideWindow(t *testing.T) {
+	history := NewHistory(time.Minute)
+
+	base := time.Now()
+
+	history.Add(Sample{Time: base, CPUUsageRatio: 0.1})
+	history.Add(Sample{Time: base.Add(30 * time.Second), CPUUsageRatio: 0.2})
+	history.Add(Sample{Time: base.Add(90 * time.Second), CPUUsageRatio: 0.3})
+
+	samples := history.Samples()
+	require.Len(t, samples, 2)
+	assert.InDelta(t, 0.2, samples[0].CPUUsageRatio, 0.001)
+	assert.InDelta(t, 0.3, samples[1].CPUUsageRatio, 0.001)
+}
+
+func TestHistorySamplesReturnsACopy(t *testing.T) {
+	history := NewHistory(time.Minute)
+	history.Add(Sample{Time: time.Now(), CPUUsageRatio: 0.5})
+
+	samples := history.Samples()
+	samples[0].CPUUsageRatio = 0.9
+
+	assert.InDelta(t, 0.5, history.Samples()[0].CPUUsageRatio, 0.001)
+}
+
+func TestHistoriesCreatesPerNodeHistoryLazily(t *testing.T) {
+	histories := NewHistories(time.Minute)
+
+	first := histories.History("node-a")
+	second := histories.History("node-a")
+	other := histories.History("node-b")
+
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+}
@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"sort"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// NetIORate is the throughput and error rate of a single network link, in units per second.
+type NetIORate struct {
+	Device    string
+	RxBytes   float64
+	TxBytes   float64
+	RxPackets float64
+	TxPackets float64
+	RxErrors  float64
+	TxErrors  float64
+}
+
+// SetNetworkDeviceStats updates the node's network device stats, keeping the previous sample
+// (and its sample time) around so that NetIORates can compute a per-second rate from the delta
+// between the two.
+func (node *Node) SetNetworkDeviceStats(stats *machine.NetworkDeviceStatsResponse, now time.Time) {
+	node.prevNetworkDeviceStats = node.NetworkDeviceStats
+	node.prevNetworkDeviceStatsAt = node.networkDeviceStatsAt
+
+	node.NetworkDeviceStats = stats
+	node.networkDeviceStatsAt = now
+}
+
+// NetIORates returns the throughput and error rate of every network link known in the most
+// recent sample, ordered by device name. Links that were not present in the previous sample
+// (e.g. a link that was just created) are skipped, since no rate can be derived for them yet.
+func (node *Node) NetIORates() []NetIORate {
+	if node.NetworkDeviceStats == nil || node.prevNetworkDeviceStats == nil {
+		return nil
+	}
+
+	elapsed := node.networkDeviceStatsAt.Sub(node.prevNetworkDeviceStatsAt).Seconds()
+	if elapsed <= 0 {
+		return nil
+	}
+
+	prevByDevice := make(map[string]*machine.NetDev, len(node.prevNetworkDeviceStats.GetDevices()))
+	for _, dev := range node.prevNetworkDeviceStats.GetDevices() {
+		prevByDevice[dev.GetName()] = dev
+	}
+
+	rates := make([]NetIORate, 0, len(node.NetworkDeviceStats.GetDevices()))
+
+	for _, dev := range node.NetworkDeviceStats.GetDevices() {
+		prev, ok := prevByDevice[dev.GetName()]
+		if !ok {
+			continue
+		}
+
+		rates = append(rates, NetIORate{
+			Device:    dev.GetName(),
+			RxBytes:   float64(deltaUint64(prev.GetRxBytes(), dev.GetRxBytes())) / elapsed,
+			TxBytes:   float64(deltaUint64(prev.GetTxBytes(), dev.GetTxBytes())) / elapsed,
+			RxPackets: float64(deltaUint64(prev.GetRxPackets(), dev.GetRxPackets())) / elapsed,
+			TxPackets: float64(deltaUint64(prev.GetTxPackets(), dev.GetTxPackets())) / elapsed,
+			RxErrors:  float64(deltaUint64(prev.GetRxErrors(), dev.GetRxErrors())) / elapsed,
+			TxErrors:  float64(deltaUint64(prev.GetTxErrors(), dev.GetTxErrors())) / elapsed,
+		})
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Device < rates[j].Device })
+
+	return rates
+}
+
+// TotalNetBytes returns the cumulative rx/tx byte counters summed across every network link in
+// the most recent sample. Unlike TotalNetIORate, this is a monotonic counter suitable for
+// exporting as a Prometheus counter metric.
+func (node *Node) TotalNetBytes() (rx, tx uint64) {
+	if node.NetworkDeviceStats == nil {
+		return 0, 0
+	}
+
+	for _, dev := range node.NetworkDeviceStats.GetDevices() {
+		rx += dev.GetRxBytes()
+		tx += dev.GetTxBytes()
+	}
+
+	return rx, tx
+}
+
+// TotalNetIORate sums NetIORates across every device, for a one-line dashboard summary.
+func (node *Node) TotalNetIORate() NetIORate {
+	var total NetIORate
+
+	for _, rate := range node.NetIORates() {
+		total.RxBytes += rate.RxBytes
+		total.TxBytes += rate.TxBytes
+		total.RxPackets += rate.RxPackets
+		total.TxPackets += rate.TxPackets
+		total.RxErrors += rate.RxErrors
+		total.TxErrors += rate.TxErrors
+	}
+
+	return total
+}
+
+// TotalDiskIORate sums DiskIORates across every device, for a one-line dashboard summary.
+func (node *Node) TotalDiskIORate() DiskIORate {
+	var total DiskIORate
+
+	for _, rate := range node.DiskIORates() {
+		total.ReadBytes += rate.ReadBytes
+		total.WriteBytes += rate.WriteBytes
+	}
+
+	return total
+}
@@ -0,0 +1,54 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package apidata
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestNetIORatesHandlesDeviceAddAndWraparound(t *testing.T) {
+	now := time.Now()
+
+	node := &Node{}
+	node.SetNetworkDeviceStats(&machine.NetworkDeviceStatsResponse{
+		Devices: []*machine.NetDev{
+			{Name: "eth0", RxBytes: math.MaxUint64 - 9, TxBytes: 1000},
+		},
+	}, now)
+	node.SetNetworkDeviceStats(&machine.NetworkDeviceStatsResponse{
+		Devices: []*machine.NetDev{
+			{Name: "eth0", RxBytes: 10, TxBytes: 2000},
+			{Name: "eth1", RxBytes: 5, TxBytes: 5},
+		},
+	}, now.Add(time.Second))
+
+	rates := node.NetIORates()
+	require.Len(t, rates, 1)
+	assert.Equal(t, "eth0", rates[0].Device)
+	// RxBytes wrapped: MaxUint64-9 -> 10 is a delta of 20, not a negative/huge number.
+	assert.InDelta(t, 20, rates[0].RxBytes, 0.001)
+	assert.InDelta(t, 1000, rates[0].TxBytes, 0.001)
+}
+
+func TestTotalNetBytesSumsAcrossLinks(t *testing.T) {
+	node := &Node{}
+	node.SetNetworkDeviceStats(&machine.NetworkDeviceStatsResponse{
+		Devices: []*machine.NetDev{
+			{Name: "eth0", RxBytes: 10, TxBytes: 20},
+			{Name: "eth1", RxBytes: 30, TxBytes: 40},
+		},
+	}, time.Now())
+
+	rx, tx := node.TotalNetBytes()
+	assert.EqualValues(t, 40, rx)
+	assert.EqualValues(t, 60, tx)
+}
@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package app assembles the built-in dashboard components, any plugins loaded from a
+// directory, and (optionally) the Prometheus exporter into a single running `talosctl
+// dashboard`. It is the one place that instantiates every widget package under
+// internal/pkg/dashboard - component/plugin/exporter packages stay importable and testable on
+// their own, but this is where they actually get wired into a grid and fed data.
+//
+// It's a separate package (rather than living in internal/pkg/dashboard itself) because
+// internal/pkg/dashboard/components already imports internal/pkg/dashboard for
+// dashboard.LayoutHint, and this package needs to import both.
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/components"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/exporter"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/plugin"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+)
+
+// Options configures a new App.
+type Options struct {
+	// Nodes are the nodes available for selection, in display order. At least one is required.
+	Nodes []string
+	// PluginDir is scanned for `.so` plugin widgets at startup. Optional; see plugin.Load.
+	PluginDir string
+	// MetricsAddr, if non-empty, starts a Prometheus exporter listening on this address
+	// alongside the interactive dashboard (see internal/pkg/dashboard/exporter).
+	MetricsAddr string
+}
+
+type nodeSelectListener interface {
+	OnNodeSelect(node string)
+}
+
+type resourceDataListener interface {
+	OnResourceDataChange(data resourcedata.Data)
+}
+
+type apiDataListener interface {
+	OnAPIDataChange(node string, data *apidata.Data)
+}
+
+// App is a running `talosctl dashboard`: one tview.Application, one grid of built-in widgets
+// and plugins, and the fan-out that keeps all of them in sync with the node selector and with
+// whatever feeds it apidata/resourcedata updates (see UpdateAPIData, UpdateResourceData).
+type App struct {
+	application *tview.Application
+
+	header    *components.Header
+	cpuDetail *components.CPUDetail
+	diskIO    *components.DiskIO
+	netIO     *components.NetIO
+	sensors   *components.Sensors
+	graphs    *components.Graphs
+	histories *apidata.Histories
+
+	plugins  []dashboard.Widget
+	exporter *exporter.Exporter
+
+	metricsAddr string
+
+	nodeSelectListeners   []nodeSelectListener
+	resourceDataListeners []resourceDataListener
+	apiDataListeners      []apiDataListener
+
+	nodes        []string
+	selectedNode int
+}
+
+// New builds an App from options: every built-in widget, every plugin found in
+// options.PluginDir, and (if options.MetricsAddr is set) the Prometheus exporter.
+func New(options Options) (*App, error) {
+	if len(options.Nodes) == 0 {
+		return nil, fmt.Errorf("dashboard: at least one node is required")
+	}
+
+	histories := apidata.NewHistories(apidata.DefaultHistoryWindow)
+
+	app := &App{
+		application: tview.NewApplication(),
+		header:      components.NewHeader(),
+		cpuDetail:   components.NewCPUDetail(),
+		diskIO:      components.NewDiskIO(),
+		netIO:       components.NewNetIO(),
+		sensors:     components.NewSensors(),
+		graphs:      components.NewGraphs(histories),
+		histories:   histories,
+		metricsAddr: options.MetricsAddr,
+		nodes:       options.Nodes,
+	}
+
+	app.nodeSelectListeners = []nodeSelectListener{app.header, app.cpuDetail, app.diskIO, app.netIO, app.sensors, app.graphs}
+	app.resourceDataListeners = []resourceDataListener{app.header, app.sensors}
+	app.apiDataListeners = []apiDataListener{app.header, app.cpuDetail, app.diskIO, app.netIO, app.graphs, app.histories}
+
+	if options.MetricsAddr != "" {
+		app.exporter = exporter.New()
+		app.resourceDataListeners = append(app.resourceDataListeners, app.exporter)
+		app.apiDataListeners = append(app.apiDataListeners, app.exporter)
+	}
+
+	if options.PluginDir != "" {
+		plugins, err := plugin.Load(options.PluginDir)
+		if err != nil {
+			return nil, fmt.Errorf("dashboard: loading plugins from %q: %w", options.PluginDir, err)
+		}
+
+		app.plugins = plugins
+	}
+
+	for _, widget := range app.plugins {
+		app.nodeSelectListeners = append(app.nodeSelectListeners, widget)
+		app.resourceDataListeners = append(app.resourceDataListeners, widget)
+		app.apiDataListeners = append(app.apiDataListeners, widget)
+	}
+
+	app.application.SetRoot(app.buildGrid(), true)
+	app.application.SetInputCapture(app.handleKey)
+
+	app.selectNode(options.Nodes[0])
+
+	return app, nil
+}
+
+// buildGrid lays out the built-in widgets, then adds every plugin at the cell its
+// dashboard.LayoutHint asks for.
+func (app *App) buildGrid() *tview.Grid {
+	grid := tview.NewGrid().
+		SetRows(3, 0, 0).
+		SetColumns(0, 0, 0).
+		SetBorders(true)
+
+	grid.AddItem(app.header, 0, 0, 1, 3, 0, 0, false)
+	grid.AddItem(app.cpuDetail, 1, 0, 1, 1, 0, 0, false)
+	grid.AddItem(app.diskIO, 1, 1, 1, 1, 0, 0, false)
+	grid.AddItem(app.netIO, 1, 2, 1, 1, 0, 0, false)
+	grid.AddItem(app.sensors, 2, 0, 1, 1, 0, 0, false)
+	grid.AddItem(app.graphs, 2, 1, 1, 2, 0, 0, true)
+
+	for _, widget := range app.plugins {
+		hint := widget.Layout()
+		grid.AddItem(widget.Primitive(), hint.Row, hint.Col, hint.RowSpan, hint.ColSpan, 0, 0, false)
+	}
+
+	return grid
+}
+
+func (app *App) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyTab, tcell.KeyRight:
+		app.cycleNode(1)
+
+		return nil
+	case tcell.KeyBacktab, tcell.KeyLeft:
+		app.cycleNode(-1)
+
+		return nil
+	}
+
+	return event
+}
+
+func (app *App) cycleNode(delta int) {
+	next := (app.selectedNode + delta + len(app.nodes)) % len(app.nodes)
+
+	app.selectNode(app.nodes[next])
+}
+
+func (app *App) selectNode(node string) {
+	for i, n := range app.nodes {
+		if n == node {
+			app.selectedNode = i
+
+			break
+		}
+	}
+
+	for _, listener := range app.nodeSelectListeners {
+		listener.OnNodeSelect(node)
+	}
+}
+
+// UpdateAPIData feeds a fresh Talos API poll result to every widget/plugin/exporter that wants
+// it. Safe to call from any goroutine - the update is marshaled onto tview's event loop.
+func (app *App) UpdateAPIData(node string, data *apidata.Data) {
+	app.application.QueueUpdateDraw(func() {
+		for _, listener := range app.apiDataListeners {
+			listener.OnAPIDataChange(node, data)
+		}
+	})
+}
+
+// UpdateResourceData feeds a single COSI resource change to every widget/plugin/exporter that
+// wants it. Safe to call from any goroutine - the update is marshaled onto tview's event loop.
+func (app *App) UpdateResourceData(data resourcedata.Data) {
+	app.application.QueueUpdateDraw(func() {
+		for _, listener := range app.resourceDataListeners {
+			listener.OnResourceDataChange(data)
+		}
+	})
+}
+
+// Nodes returns the nodes the dashboard was started with, in display order.
+func (app *App) Nodes() []string {
+	return app.nodes
+}
+
+// Run starts the exporter (if configured) and blocks running the interactive TUI until ctx is
+// canceled or the user quits.
+func (app *App) Run(ctx context.Context) error {
+	if app.exporter != nil {
+		go func() {
+			if err := app.exporter.ListenAndServe(ctx, app.metricsAddr); err != nil {
+				app.application.QueueUpdateDraw(func() {
+					app.header.SetText(fmt.Sprintf("[red::b]metrics exporter failed: %s[-:-:-]", err))
+				})
+			}
+		}()
+	}
+
+	go func() {
+		<-ctx.Done()
+		app.application.Stop()
+	}()
+
+	return app.application.Run()
+}
@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+)
+
+// sparkChars are used to render a per-core utilization bar, from least to most busy.
+var sparkChars = []rune(" ▁▂▃▄▅▆▇█")
+
+// CPUDetail represents a per-core CPU utilization and breakdown panel.
+type CPUDetail struct {
+	tview.TextView
+
+	selectedNode string
+	nodeMap      map[string][]apidata.CPUCoreUsage
+}
+
+// NewCPUDetail initializes CPUDetail.
+func NewCPUDetail() *CPUDetail {
+	widget := &CPUDetail{
+		TextView: *tview.NewTextView(),
+		nodeMap:  make(map[string][]apidata.CPUCoreUsage),
+	}
+
+	widget.SetDynamicColors(true).SetText(noData)
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *CPUDetail) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *CPUDetail) OnAPIDataChange(node string, data *apidata.Data) {
+	nodeAPIData := data.Nodes[node]
+	if nodeAPIData != nil {
+		widget.nodeMap[node] = nodeAPIData.CPUBreakdown()
+	}
+
+	if node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *CPUDetail) redraw() {
+	breakdown := widget.nodeMap[widget.selectedNode]
+	if len(breakdown) == 0 {
+		widget.SetText(noData)
+
+		return
+	}
+
+	var sb strings.Builder
+
+	for _, core := range breakdown {
+		busy := core.User + core.Nice + core.System + core.IOWait + core.IRQ + core.SoftIRQ + core.Steal
+
+		fmt.Fprintf(&sb, "%-6s %s %5.1f%%  usr %4.1f%% sys %4.1f%% io %4.1f%% irq %4.1f%%\n",
+			core.ID,
+			string(sparkChars[sparkIndex(busy)]),
+			busy*100,
+			core.User*100,
+			core.System*100,
+			core.IOWait*100,
+			(core.IRQ+core.SoftIRQ)*100,
+		)
+	}
+
+	widget.SetText(sb.String())
+}
+
+func sparkIndex(ratio float64) int {
+	idx := int(ratio * float64(len(sparkChars)-1))
+
+	switch {
+	case idx < 0:
+		return 0
+	case idx >= len(sparkChars):
+		return len(sparkChars) - 1
+	default:
+		return idx
+	}
+}
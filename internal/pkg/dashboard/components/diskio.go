@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+)
+
+// DiskIO represents the per-device disk read/write throughput panel.
+type DiskIO struct {
+	tview.TextView
+
+	selectedNode string
+	nodeMap      map[string][]apidata.DiskIORate
+}
+
+// NewDiskIO initializes DiskIO.
+func NewDiskIO() *DiskIO {
+	widget := &DiskIO{
+		TextView: *tview.NewTextView(),
+		nodeMap:  make(map[string][]apidata.DiskIORate),
+	}
+
+	widget.SetDynamicColors(true).SetText(noData)
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *DiskIO) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *DiskIO) OnAPIDataChange(node string, data *apidata.Data) {
+	nodeAPIData := data.Nodes[node]
+	if nodeAPIData != nil {
+		widget.nodeMap[node] = nodeAPIData.DiskIORates()
+	}
+
+	if node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *DiskIO) redraw() {
+	rates := widget.nodeMap[widget.selectedNode]
+	if len(rates) == 0 {
+		widget.SetText(noData)
+
+		return
+	}
+
+	var sb strings.Builder
+
+	for _, rate := range rates {
+		fmt.Fprintf(&sb, "%-12s R %9s/s  W %9s/s\n",
+			rate.Device,
+			humanize.IBytes(uint64(rate.ReadBytes)),
+			humanize.IBytes(uint64(rate.WriteBytes)),
+		)
+	}
+
+	widget.SetText(sb.String())
+}
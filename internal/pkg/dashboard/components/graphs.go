@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/navidys/tvxwidgets"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+)
+
+// zoomWindows are the time windows cyclable with the 'z' key, shortest first.
+var zoomWindows = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, time.Hour}
+
+// Graphs renders scrolling line charts of CPU%, mem% and net/disk rates for the selected
+// node, reading from a shared apidata.HistoryProvider. CPU/mem share one plot since both are
+// 0-100 ranges; net/disk rates get their own plot below since bytes/sec lives on a wildly
+// different scale. Load average is not plotted.
+type Graphs struct {
+	*tview.Flex
+
+	usage *tvxwidgets.Plot
+	rates *tvxwidgets.Plot
+
+	provider     apidata.HistoryProvider
+	selectedNode string
+	zoom         int
+	paused       bool
+}
+
+// NewGraphs initializes Graphs against a shared HistoryProvider (see apidata.Histories).
+func NewGraphs(provider apidata.HistoryProvider) *Graphs {
+	widget := &Graphs{
+		Flex:     tview.NewFlex().SetDirection(tview.FlexRow),
+		usage:    tvxwidgets.NewPlot(),
+		rates:    tvxwidgets.NewPlot(),
+		provider: provider,
+	}
+
+	widget.usage.SetBorder(true)
+	widget.usage.SetTitle(" cpu % / mem % (z: zoom, space: pause, d: dump csv, D: dump json) ")
+	widget.usage.SetMarker(tvxwidgets.PlotMarkerBraille)
+	widget.usage.SetLineColor([]tcell.Color{tcell.ColorGreen, tcell.ColorBlue})
+
+	widget.rates.SetBorder(true)
+	widget.rates.SetTitle(" net rx/tx, disk read/write (bytes/sec) ")
+	widget.rates.SetMarker(tvxwidgets.PlotMarkerBraille)
+	widget.rates.SetLineColor([]tcell.Color{tcell.ColorGreen, tcell.ColorRed, tcell.ColorBlue, tcell.ColorYellow})
+
+	widget.AddItem(widget.usage, 0, 1, true)
+	widget.AddItem(widget.rates, 0, 1, false)
+
+	widget.SetInputCapture(widget.handleKey)
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *Graphs) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface. Graphs doesn't record samples
+// itself (that's apidata.Histories' job, shared across widgets) - it just redraws from them.
+func (widget *Graphs) OnAPIDataChange(node string, _ *apidata.Data) {
+	if !widget.paused && node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+// Primitive implements the dashboard.Widget interface.
+func (widget *Graphs) Primitive() tview.Primitive {
+	return widget
+}
+
+// Layout implements the dashboard.Widget interface.
+func (widget *Graphs) Layout() dashboard.LayoutHint {
+	return dashboard.LayoutHint{Row: 2, Col: 1, RowSpan: 1, ColSpan: 2}
+}
+
+func (widget *Graphs) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch {
+	case event.Rune() == 'z':
+		widget.zoom = (widget.zoom + 1) % len(zoomWindows)
+		widget.redraw()
+
+		return nil
+	case event.Rune() == ' ':
+		widget.paused = !widget.paused
+
+		return nil
+	case event.Rune() == 'd':
+		if err := widget.dump(); err != nil {
+			widget.usage.SetTitle(fmt.Sprintf(" dump failed: %s ", err))
+		}
+
+		return nil
+	case event.Rune() == 'D':
+		if err := widget.dumpJSON(); err != nil {
+			widget.usage.SetTitle(fmt.Sprintf(" dump failed: %s ", err))
+		}
+
+		return nil
+	}
+
+	return event
+}
+
+func (widget *Graphs) window() time.Duration {
+	return zoomWindows[widget.zoom]
+}
+
+func (widget *Graphs) samplesInWindow() []apidata.Sample {
+	history := widget.provider.History(widget.selectedNode)
+	if history == nil {
+		return nil
+	}
+
+	all := history.Samples()
+	if len(all) == 0 {
+		return nil
+	}
+
+	cutoff := all[len(all)-1].Time.Add(-widget.window())
+
+	start := 0
+	for start < len(all) && all[start].Time.Before(cutoff) {
+		start++
+	}
+
+	return all[start:]
+}
+
+func (widget *Graphs) redraw() {
+	samples := widget.samplesInWindow()
+
+	cpu := make([]float64, len(samples))
+	mem := make([]float64, len(samples))
+	netRx := make([]float64, len(samples))
+	netTx := make([]float64, len(samples))
+	diskRead := make([]float64, len(samples))
+	diskWrite := make([]float64, len(samples))
+
+	for i, sample := range samples {
+		cpu[i] = sample.CPUUsageRatio * 100
+		mem[i] = sample.MemUsageRatio * 100
+		netRx[i] = sample.NetRxBytes
+		netTx[i] = sample.NetTxBytes
+		diskRead[i] = sample.DiskReadBytes
+		diskWrite[i] = sample.DiskWriteBytes
+	}
+
+	widget.usage.SetData([][]float64{cpu, mem})
+	widget.rates.SetData([][]float64{netRx, netTx, diskRead, diskWrite})
+}
+
+// dump writes the selected node's current history window to a timestamped CSV file in the
+// working directory, e.g. "talos-dashboard-20260726-153000.csv".
+func (widget *Graphs) dump() error {
+	samples := widget.samplesInWindow()
+
+	name := fmt.Sprintf("talos-dashboard-%s.csv", time.Now().Format("20060102-150405"))
+
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close() //nolint:errcheck
+
+	writer := csv.NewWriter(file)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"time", "cpu_usage_ratio", "mem_usage_ratio", "net_rx_bytes", "net_tx_bytes", "disk_read_bytes", "disk_write_bytes"}); err != nil {
+		return err
+	}
+
+	for _, sample := range samples {
+		record := []string{
+			sample.Time.Format(time.RFC3339),
+			strconv.FormatFloat(sample.CPUUsageRatio, 'f', -1, 64),
+			strconv.FormatFloat(sample.MemUsageRatio, 'f', -1, 64),
+			strconv.FormatFloat(sample.NetRxBytes, 'f', -1, 64),
+			strconv.FormatFloat(sample.NetTxBytes, 'f', -1, 64),
+			strconv.FormatFloat(sample.DiskReadBytes, 'f', -1, 64),
+			strconv.FormatFloat(sample.DiskWriteBytes, 'f', -1, 64),
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dumpJSON writes the selected node's current history window to a timestamped JSON file,
+// for callers that prefer structured data over CSV.
+func (widget *Graphs) dumpJSON() error {
+	samples := widget.samplesInWindow()
+
+	name := fmt.Sprintf("talos-dashboard-%s.json", time.Now().Format("20060102-150405"))
+
+	data, err := json.MarshalIndent(samples, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, data, 0o644)
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
 	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -29,6 +30,9 @@ type headerData struct {
 	numProcesses    string
 	cpuUsagePercent string
 	memUsagePercent string
+	netIOSummary    string
+	diskIOSummary   string
+	tempSummary     string
 }
 
 // Header represents the top bar with host info.
@@ -37,13 +41,15 @@ type Header struct {
 
 	selectedNode string
 	nodeMap      map[string]*headerData
+	sensorTemps  map[string]map[string]float64
 }
 
 // NewHeader initializes Header.
 func NewHeader() *Header {
 	header := &Header{
-		TextView: *tview.NewTextView(),
-		nodeMap:  make(map[string]*headerData),
+		TextView:    *tview.NewTextView(),
+		nodeMap:     make(map[string]*headerData),
+		sensorTemps: make(map[string]map[string]float64),
 	}
 
 	header.SetDynamicColors(true).SetText(noData)
@@ -64,13 +70,16 @@ func (widget *Header) OnNodeSelect(node string) {
 func (widget *Header) OnResourceDataChange(data resourcedata.Data) {
 	nodeData := widget.getOrCreateNodeData(data.Node)
 
-	switch res := data.Resource.(type) { //nolint:gocritic
+	switch res := data.Resource.(type) {
 	case *network.HostnameStatus:
 		if data.Deleted {
 			nodeData.hostname = noHostname
 		} else {
 			nodeData.hostname = res.TypedSpec().Hostname
 		}
+	case *hardware.SensorStatus:
+		widget.updateSensorTemp(data.Node, res, data.Deleted)
+		nodeData.tempSummary = widget.hottestSensorTemp(data.Node)
 	}
 
 	if data.Node == widget.selectedNode {
@@ -78,6 +87,40 @@ func (widget *Header) OnResourceDataChange(data resourcedata.Data) {
 	}
 }
 
+func (widget *Header) updateSensorTemp(node string, status *hardware.SensorStatus, deleted bool) {
+	temps, ok := widget.sensorTemps[node]
+	if !ok {
+		temps = make(map[string]float64)
+		widget.sensorTemps[node] = temps
+	}
+
+	id := status.Metadata().ID()
+
+	if deleted {
+		delete(temps, id)
+
+		return
+	}
+
+	if temp := status.TypedSpec().TemperatureCelsius; temp != nil {
+		temps[id] = *temp
+	}
+}
+
+func (widget *Header) hottestSensorTemp(node string) string {
+	temps := widget.sensorTemps[node]
+	if len(temps) == 0 {
+		return notAvailable
+	}
+
+	hottest := math.Inf(-1)
+	for _, temp := range temps {
+		hottest = math.Max(hottest, temp)
+	}
+
+	return fmt.Sprintf("%.0f°C", hottest)
+}
+
 // OnAPIDataChange implements the APIDataListener interface.
 func (widget *Header) OnAPIDataChange(node string, data *apidata.Data) {
 	nodeAPIData := data.Nodes[node]
@@ -106,7 +149,7 @@ func (widget *Header) redraw() {
 	data := widget.getOrCreateNodeData(widget.selectedNode)
 
 	text := fmt.Sprintf(
-		"[yellow::b]%s[-:-:-] (%s): uptime %s, %sx%s, %s RAM, PROCS %s, CPU %s, RAM %s",
+		"[yellow::b]%s[-:-:-] (%s): uptime %s, %sx%s, %s RAM, PROCS %s, CPU %s, RAM %s, %s, %s, TEMP %s",
 		data.hostname,
 		data.version,
 		data.uptime,
@@ -116,6 +159,9 @@ func (widget *Header) redraw() {
 		data.numProcesses,
 		data.cpuUsagePercent,
 		data.memUsagePercent,
+		data.netIOSummary,
+		data.diskIOSummary,
+		data.tempSummary,
 	)
 
 	widget.SetText(text)
@@ -155,6 +201,12 @@ func (widget *Header) updateNodeAPIData(node string, data *apidata.Node) {
 	if data.Memory != nil {
 		sss.totalMem = humanize.IBytes(data.Memory.GetMeminfo().GetMemtotal() << 10)
 	}
+
+	netRate := data.TotalNetIORate()
+	sss.netIOSummary = fmt.Sprintf("NET ↑%s/s ↓%s/s", humanize.IBytes(uint64(netRate.TxBytes)), humanize.IBytes(uint64(netRate.RxBytes)))
+
+	diskRate := data.TotalDiskIORate()
+	sss.diskIOSummary = fmt.Sprintf("DISK ↑%s/s ↓%s/s", humanize.IBytes(uint64(diskRate.WriteBytes)), humanize.IBytes(uint64(diskRate.ReadBytes)))
 }
 
 func (widget *Header) getOrCreateNodeData(node string) *headerData {
@@ -170,6 +222,9 @@ func (widget *Header) getOrCreateNodeData(node string) *headerData {
 			numProcesses:    notAvailable,
 			cpuUsagePercent: notAvailable,
 			memUsagePercent: notAvailable,
+			netIOSummary:    notAvailable,
+			diskIOSummary:   notAvailable,
+			tempSummary:     notAvailable,
 		}
 
 		widget.nodeMap[node] = data
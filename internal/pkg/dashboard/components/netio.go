@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+)
+
+// NetIO represents the per-link network throughput panel.
+type NetIO struct {
+	tview.TextView
+
+	selectedNode string
+	nodeMap      map[string][]apidata.NetIORate
+}
+
+// NewNetIO initializes NetIO.
+func NewNetIO() *NetIO {
+	widget := &NetIO{
+		TextView: *tview.NewTextView(),
+		nodeMap:  make(map[string][]apidata.NetIORate),
+	}
+
+	widget.SetDynamicColors(true).SetText(noData)
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *NetIO) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (widget *NetIO) OnAPIDataChange(node string, data *apidata.Data) {
+	nodeAPIData := data.Nodes[node]
+	if nodeAPIData != nil {
+		widget.nodeMap[node] = nodeAPIData.NetIORates()
+	}
+
+	if node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *NetIO) redraw() {
+	rates := widget.nodeMap[widget.selectedNode]
+	if len(rates) == 0 {
+		widget.SetText(noData)
+
+		return
+	}
+
+	var sb strings.Builder
+
+	for _, rate := range rates {
+		fmt.Fprintf(&sb, "%-12s ↓ %9s/s  ↑ %9s/s  errs %d/%d\n",
+			rate.Device,
+			humanize.IBytes(uint64(rate.RxBytes)),
+			humanize.IBytes(uint64(rate.TxBytes)),
+			uint64(rate.RxErrors),
+			uint64(rate.TxErrors),
+		)
+	}
+
+	widget.SetText(sb.String())
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+)
+
+// Sensors represents the temperature and fan sensor panel.
+type Sensors struct {
+	tview.TextView
+
+	selectedNode string
+	nodeMap      map[string]map[string]*hardware.SensorStatusSpec
+	fahrenheit   bool
+}
+
+// NewSensors initializes Sensors.
+func NewSensors() *Sensors {
+	widget := &Sensors{
+		TextView: *tview.NewTextView(),
+		nodeMap:  make(map[string]map[string]*hardware.SensorStatusSpec),
+	}
+
+	widget.SetDynamicColors(true).SetText(noData)
+
+	widget.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Rune() == 'f' {
+			widget.fahrenheit = !widget.fahrenheit
+			widget.redraw()
+
+			return nil
+		}
+
+		return event
+	})
+
+	return widget
+}
+
+// OnNodeSelect implements the NodeSelectListener interface.
+func (widget *Sensors) OnNodeSelect(node string) {
+	if node != widget.selectedNode {
+		widget.selectedNode = node
+
+		widget.redraw()
+	}
+}
+
+// OnResourceDataChange implements the ResourceDataListener interface.
+func (widget *Sensors) OnResourceDataChange(data resourcedata.Data) {
+	sensors := widget.getOrCreateNodeSensors(data.Node)
+
+	status, ok := data.Resource.(*hardware.SensorStatus)
+	if !ok {
+		return
+	}
+
+	if data.Deleted {
+		delete(sensors, status.Metadata().ID())
+	} else {
+		sensors[status.Metadata().ID()] = status.TypedSpec()
+	}
+
+	if data.Node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *Sensors) redraw() {
+	sensors := widget.nodeMap[widget.selectedNode]
+	if len(sensors) == 0 {
+		widget.SetText(noData)
+
+		return
+	}
+
+	ids := make([]string, 0, len(sensors))
+	for id := range sensors {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	var sb strings.Builder
+
+	for _, id := range ids {
+		spec := sensors[id]
+
+		switch {
+		case spec.TemperatureCelsius != nil:
+			fmt.Fprintf(&sb, "%s%-24s %s[-:-:-]\n", widget.tempColor(spec), id, widget.formatTemp(*spec.TemperatureCelsius))
+		case spec.FanRPM != nil:
+			fmt.Fprintf(&sb, "[white::]%-24s %d RPM\n", id, *spec.FanRPM)
+		}
+	}
+
+	widget.SetText(sb.String())
+}
+
+// tempColor picks a green/yellow/red severity color using the driver-reported tempX_max /
+// tempX_crit thresholds when present, falling back to fixed defaults otherwise.
+func (widget *Sensors) tempColor(spec *hardware.SensorStatusSpec) string {
+	temp := *spec.TemperatureCelsius
+
+	crit := 90.0
+	if spec.CriticalCelsius != nil {
+		crit = *spec.CriticalCelsius
+	}
+
+	warn := crit * 0.85
+	if spec.MaxCelsius != nil {
+		warn = *spec.MaxCelsius
+	}
+
+	switch {
+	case temp >= crit:
+		return "[red::b]"
+	case temp >= warn:
+		return "[yellow::]"
+	default:
+		return "[green::]"
+	}
+}
+
+func (widget *Sensors) formatTemp(celsius float64) string {
+	if widget.fahrenheit {
+		return fmt.Sprintf("%.1f°F", celsius*9/5+32)
+	}
+
+	return fmt.Sprintf("%.1f°C", celsius)
+}
+
+func (widget *Sensors) getOrCreateNodeSensors(node string) map[string]*hardware.SensorStatusSpec {
+	sensors, ok := widget.nodeMap[node]
+	if !ok {
+		sensors = make(map[string]*hardware.SensorStatusSpec)
+		widget.nodeMap[node] = sensors
+	}
+
+	return sensors
+}
@@ -0,0 +1,173 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package exporter turns the same apidata.Data/resourcedata.Data streams that feed the
+// dashboard widgets into Prometheus metrics, so a Talos node can be scraped for the
+// information the dashboard already collects without a separate agent.
+//
+// `talosctl dashboard --metrics-addr` (cmd/talosctl/cmd/talos/dashboard.go) starts this
+// alongside the interactive dashboard via Exporter.ListenAndServe. A machine-config option to
+// run it standalone, outside the interactive dashboard, is follow-up work tracked separately
+// from this series - the machine-config package is not present here.
+package exporter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const namespace = "talos"
+
+// Exporter implements the same node/API-data/resource-data listener methods as the dashboard
+// widgets, and exposes everything it observes as Prometheus metrics.
+type Exporter struct {
+	registry *prometheus.Registry
+
+	hostnameByNode map[string]string
+	versionByNode  map[string]string
+	nodeData       map[string]*apidata.Node
+}
+
+// New creates an Exporter and registers its collectors.
+func New() *Exporter {
+	exporter := &Exporter{
+		registry:       prometheus.NewRegistry(),
+		hostnameByNode: map[string]string{},
+		versionByNode:  map[string]string{},
+		nodeData:       map[string]*apidata.Node{},
+	}
+
+	exporter.registry.MustRegister(exporter)
+
+	return exporter
+}
+
+// OnNodeSelect implements the dashboard.Widget-shaped listener interfaces. The exporter isn't
+// node-scoped (it scrapes every node at once), so node selection is a no-op.
+func (exporter *Exporter) OnNodeSelect(string) {}
+
+// OnResourceDataChange implements the ResourceDataListener interface.
+func (exporter *Exporter) OnResourceDataChange(data resourcedata.Data) {
+	if res, ok := data.Resource.(*network.HostnameStatus); ok {
+		if data.Deleted {
+			delete(exporter.hostnameByNode, data.Node)
+		} else {
+			exporter.hostnameByNode[data.Node] = res.TypedSpec().Hostname
+		}
+	}
+}
+
+// OnAPIDataChange implements the APIDataListener interface.
+func (exporter *Exporter) OnAPIDataChange(node string, data *apidata.Data) {
+	nodeData := data.Nodes[node]
+	if nodeData == nil {
+		return
+	}
+
+	exporter.nodeData[node] = nodeData
+
+	if nodeData.Version != nil {
+		exporter.versionByNode[node] = nodeData.Version.GetVersion().GetTag()
+	}
+}
+
+// Describe implements the prometheus.Collector interface.
+func (exporter *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(exporter, ch)
+}
+
+// Collect implements the prometheus.Collector interface.
+func (exporter *Exporter) Collect(ch chan<- prometheus.Metric) {
+	for node, data := range exporter.nodeData {
+		labels := prometheus.Labels{
+			"node":     node,
+			"hostname": exporter.hostnameByNode[node],
+			"version":  exporter.versionByNode[node],
+		}
+
+		exporter.collectNode(ch, labels, data)
+	}
+}
+
+func (exporter *Exporter) collectNode(ch chan<- prometheus.Metric, labels prometheus.Labels, data *apidata.Node) {
+	emitGauge(ch, "cpu_usage_ratio", "Aggregate CPU usage ratio (0-1).", labels, data.CPUUsageByName("usage"))
+	emitGauge(ch, "mem_usage_ratio", "Memory usage ratio (0-1).", labels, data.MemUsage())
+
+	if data.Memory != nil {
+		emitGauge(ch, "mem_total_bytes", "Total memory, in bytes.", labels, float64(data.Memory.GetMeminfo().GetMemtotal()<<10))
+	}
+
+	if data.Processes != nil {
+		emitGauge(ch, "procs_total", "Number of processes.", labels, float64(len(data.Processes.GetProcesses())))
+	}
+
+	if data.SystemStat != nil {
+		uptime := time.Since(time.Unix(int64(data.SystemStat.GetBootTime()), 0))
+		emitGauge(ch, "uptime_seconds", "Seconds since boot.", labels, uptime.Seconds())
+	}
+
+	if data.CPUsInfo != nil && len(data.CPUsInfo.GetCpuInfo()) > 0 {
+		emitGauge(ch, "cpu_freq_hz", "CPU frequency, in Hz.", labels, data.CPUsInfo.GetCpuInfo()[0].GetCpuMhz()*1e6)
+	}
+
+	rx, tx := data.TotalNetBytes()
+	emitCounter(ch, "net_receive_bytes_total", "Cumulative network bytes received.", labels, float64(rx))
+	emitCounter(ch, "net_transmit_bytes_total", "Cumulative network bytes transmitted.", labels, float64(tx))
+
+	read, write := data.TotalDiskBytes()
+	emitCounter(ch, "disk_read_bytes_total", "Cumulative disk bytes read.", labels, float64(read))
+	emitCounter(ch, "disk_write_bytes_total", "Cumulative disk bytes written.", labels, float64(write))
+}
+
+func emitGauge(ch chan<- prometheus.Metric, name, help string, labels prometheus.Labels, value float64) {
+	emit(ch, prometheus.GaugeValue, name, help, labels, value)
+}
+
+func emitCounter(ch chan<- prometheus.Metric, name, help string, labels prometheus.Labels, value float64) {
+	emit(ch, prometheus.CounterValue, name, help, labels, value)
+}
+
+func emit(ch chan<- prometheus.Metric, valueType prometheus.ValueType, name, help string, labels prometheus.Labels, value float64) {
+	names := make([]string, 0, len(labels))
+	values := make([]string, 0, len(labels))
+
+	for k, v := range labels {
+		names = append(names, k)
+		values = append(values, v)
+	}
+
+	desc := prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, names, nil)
+
+	ch <- prometheus.MustNewConstMetric(desc, valueType, value, values...)
+}
+
+// ListenAndServe exposes the exporter's metrics on addr at /metrics until ctx is canceled.
+func (exporter *Exporter) ListenAndServe(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(exporter.registry, promhttp.HandlerOpts{}))
+
+	server := &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+		server.Close() //nolint:errcheck
+	}()
+
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Command podcount is an example dashboard plugin: a widget that shows how many static pods
+// (k8s.StaticPodStatus resources) the selected node reports. Other pods are not counted.
+// Build it with:
+//
+//	go build -buildmode=plugin -o podcount.so ./internal/pkg/dashboard/plugin/examples/podcount
+//
+// and point `talosctl dashboard --plugin-dir` at the directory containing podcount.so.
+package main
+
+import (
+	"fmt"
+
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+type podCountWidget struct {
+	*tview.TextView
+
+	selectedNode string
+	// pods tracks the live set of pod resource IDs per node, rather than a running counter,
+	// since COSI may deliver update events for existing pods (not just create/delete) and
+	// an initial sync can interleave creates and deletes in either order.
+	pods map[string]map[string]struct{}
+}
+
+func newPodCountWidget() *podCountWidget {
+	widget := &podCountWidget{
+		TextView: tview.NewTextView(),
+		pods:     map[string]map[string]struct{}{},
+	}
+
+	widget.SetText("POD COUNT: n/a")
+
+	return widget
+}
+
+func (widget *podCountWidget) OnNodeSelect(node string) {
+	widget.selectedNode = node
+	widget.redraw()
+}
+
+func (widget *podCountWidget) OnResourceDataChange(data resourcedata.Data) {
+	pod, ok := data.Resource.(*k8s.StaticPodStatus)
+	if !ok {
+		return
+	}
+
+	nodePods, ok := widget.pods[data.Node]
+	if !ok {
+		nodePods = map[string]struct{}{}
+		widget.pods[data.Node] = nodePods
+	}
+
+	id := pod.Metadata().ID()
+
+	if data.Deleted {
+		delete(nodePods, id)
+	} else {
+		nodePods[id] = struct{}{}
+	}
+
+	if data.Node == widget.selectedNode {
+		widget.redraw()
+	}
+}
+
+func (widget *podCountWidget) OnAPIDataChange(string, *apidata.Data) {}
+
+func (widget *podCountWidget) Primitive() tview.Primitive {
+	return widget.TextView
+}
+
+func (widget *podCountWidget) Layout() dashboard.LayoutHint {
+	return dashboard.LayoutHint{Row: 0, Col: 2, RowSpan: 1, ColSpan: 1}
+}
+
+func (widget *podCountWidget) redraw() {
+	widget.SetText(fmt.Sprintf("POD COUNT: %d", len(widget.pods[widget.selectedNode])))
+}
+
+// Widget is the symbol the dashboard plugin loader looks up.
+var Widget dashboard.Widget = newPodCountWidget()
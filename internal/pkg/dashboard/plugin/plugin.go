@@ -0,0 +1,94 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build linux
+
+// Package plugin loads extra dashboard.Widget implementations from `.so` files using Go's
+// `plugin` package, so that operators can drop bespoke panels (SMART data, IPMI events,
+// site-specific telemetry, ...) into the dashboard without forking Talos.
+//
+// # ABI
+//
+// A plugin is a regular Go plugin (`go build -buildmode=plugin`) built against the exact
+// same `github.com/siderolabs/talos` module version as the `talosctl` binary loading it -
+// the `plugin` package requires matching toolchains and dependency versions, there is no
+// version negotiation. It must export a single symbol:
+//
+//	var Widget dashboard.Widget
+//
+// initialized to a ready-to-use value (see internal/pkg/dashboard/plugin/examples/podcount
+// for a minimal example). Widgets are loaded once at dashboard startup, in the order their
+// `.so` files are returned by the directory listing (lexical order).
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"plugin"
+	"sort"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard"
+)
+
+// widgetSymbolName is the exported variable every plugin must provide.
+const widgetSymbolName = "Widget"
+
+// Load opens every `.so` file in dir and collects the dashboard.Widget each exports.
+//
+// A missing dir is not an error: plugins are optional.
+func Load(dir string) ([]dashboard.Widget, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error reading plugin directory %q: %w", dir, err)
+	}
+
+	paths := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".so" {
+			continue
+		}
+
+		paths = append(paths, filepath.Join(dir, entry.Name()))
+	}
+
+	sort.Strings(paths)
+
+	widgets := make([]dashboard.Widget, 0, len(paths))
+
+	for _, path := range paths {
+		widget, err := loadWidget(path)
+		if err != nil {
+			return nil, fmt.Errorf("error loading plugin %q: %w", path, err)
+		}
+
+		widgets = append(widgets, widget)
+	}
+
+	return widgets, nil
+}
+
+func loadWidget(path string) (dashboard.Widget, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sym, err := p.Lookup(widgetSymbolName)
+	if err != nil {
+		return nil, err
+	}
+
+	widgetPtr, ok := sym.(*dashboard.Widget)
+	if !ok {
+		return nil, fmt.Errorf("exported symbol %q is a %T, not a *dashboard.Widget", widgetSymbolName, sym)
+	}
+
+	return *widgetPtr, nil
+}
@@ -0,0 +1,24 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build !linux
+
+package plugin
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard"
+)
+
+// Load always fails on non-Linux platforms: Go's `plugin` package only supports linux.
+//
+// KNOWN GAP: the out-of-process gRPC widget protocol that was meant to cover non-Linux
+// platforms (so a plugin widget isn't Linux-only) was never built in this series - this is the
+// half of the plugin ABI request that shipped, not the whole of it. Until that protocol lands,
+// Load on non-Linux platforms must keep failing rather than silently offering a degraded ABI.
+func Load(dir string) ([]dashboard.Widget, error) {
+	return nil, fmt.Errorf("dashboard plugins are not supported on %s", runtime.GOOS)
+}
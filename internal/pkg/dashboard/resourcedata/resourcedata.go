@@ -0,0 +1,16 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package resourcedata provides a data structure which carries a single COSI resource change
+// event to the dashboard widgets.
+package resourcedata
+
+import "github.com/cosi-project/runtime/pkg/resource"
+
+// Data is a single resource change notification for a node.
+type Data struct {
+	Node     string
+	Resource resource.Resource
+	Deleted  bool
+}
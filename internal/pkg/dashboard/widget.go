@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dashboard implements the `talosctl dashboard` TUI.
+package dashboard
+
+import (
+	"github.com/rivo/tview"
+
+	"github.com/siderolabs/talos/internal/pkg/dashboard/apidata"
+	"github.com/siderolabs/talos/internal/pkg/dashboard/resourcedata"
+)
+
+// LayoutHint tells the dashboard grid where a Widget would like to be placed.
+//
+// Row/Col are grid cell coordinates, RowSpan/ColSpan let a widget occupy more than one cell.
+// The built-in components use the same grid, so a plugin widget slots in next to them.
+type LayoutHint struct {
+	Row     int
+	Col     int
+	RowSpan int
+	ColSpan int
+}
+
+// Widget is the interface every dashboard panel (built-in or plugin) implements.
+//
+// It mirrors the informal interfaces already satisfied by the built-in components in
+// internal/pkg/dashboard/components (Header, CPUDetail, DiskIO, ...), plus the two methods
+// (Primitive and Layout) the dashboard needs to place a widget it didn't compile in.
+type Widget interface {
+	// OnNodeSelect is called whenever the node selector changes.
+	OnNodeSelect(node string)
+	// OnResourceDataChange is called on every COSI resource change for any watched node.
+	OnResourceDataChange(data resourcedata.Data)
+	// OnAPIDataChange is called on every Talos API poll for any watched node.
+	OnAPIDataChange(node string, data *apidata.Data)
+	// Primitive returns the tview primitive to place in the dashboard grid.
+	Primitive() tview.Primitive
+	// Layout returns where in the dashboard grid the widget would like to be placed.
+	Layout() LayoutHint
+}
@@ -0,0 +1,11 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package hardware provides resources which describe the physical machine, e.g. sensors.
+package hardware
+
+import "github.com/cosi-project/runtime/pkg/resource"
+
+// NamespaceName is the namespace for hardware resources.
+const NamespaceName resource.Namespace = "hardware"
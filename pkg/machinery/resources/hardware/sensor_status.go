@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+)
+
+// SensorStatusType is the type of SensorStatus resource.
+const SensorStatusType = resource.Type("SensorStatuses.hardware.talos.dev")
+
+// SensorStatus resource holds a single hwmon sensor reading (a temperature or a fan speed).
+//
+// The resource ID is "<chip>/<label>", e.g. "coretemp/Core 0".
+type SensorStatus = typed.Resource[SensorStatusSpec, SensorStatusExtension]
+
+// SensorStatusSpec describes a hwmon sensor reading.
+//
+//gotagsrewrite:gen
+type SensorStatusSpec struct {
+	// Chip is the hwmon driver name, e.g. "coretemp", "k10temp", "nvme".
+	Chip string `yaml:"chip" protobuf:"1"`
+	// Label is the sensor label as reported by the driver, e.g. "Core 0", "fan1".
+	Label string `yaml:"label" protobuf:"2"`
+	// TemperatureCelsius is set for temperature sensors.
+	TemperatureCelsius *float64 `yaml:"temperatureCelsius,omitempty" protobuf:"3"`
+	// FanRPM is set for fan speed sensors.
+	FanRPM *uint32 `yaml:"fanRPM,omitempty" protobuf:"4"`
+	// MaxCelsius is the driver-reported warning threshold (tempX_max), if any.
+	MaxCelsius *float64 `yaml:"maxCelsius,omitempty" protobuf:"5"`
+	// CriticalCelsius is the driver-reported critical threshold (tempX_crit), if any.
+	CriticalCelsius *float64 `yaml:"criticalCelsius,omitempty" protobuf:"6"`
+}
+
+// NewSensorStatus initializes a SensorStatus resource.
+func NewSensorStatus(id string) *SensorStatus {
+	return typed.NewResource[SensorStatusSpec, SensorStatusExtension](
+		resource.NewMetadata(NamespaceName, SensorStatusType, id, resource.VersionUndefined),
+		SensorStatusSpec{},
+	)
+}
+
+// SensorStatusExtension provides auxiliary methods for SensorStatus.
+type SensorStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SensorStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SensorStatusType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Chip",
+				JSONPath: "{.chip}",
+			},
+			{
+				Name:     "Label",
+				JSONPath: "{.label}",
+			},
+			{
+				Name:     "Temperature",
+				JSONPath: "{.temperatureCelsius}",
+			},
+		},
+	}
+}
+
+func init() {
+	if err := protobuf.RegisterDynamic[SensorStatusSpec](SensorStatusType, &SensorStatus{}); err != nil {
+		panic(err)
+	}
+}